@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"errors"
+	"sort"
+)
+
+/* Collection gives package managers and registries a ready-made way to sort
+ * and pick from a list of version strings without hand-rolling loops around
+ * IsNewer. Precedence follows the same rules as OlderThan/NewerThan, so
+ * build metadata never affects ordering.
+ */
+
+// Collection is a sortable list of Semver values, in ascending precedence order.
+type Collection []Semver
+
+func (c Collection) Len() int           { return len(c) }
+func (c Collection) Less(i, j int) bool { return c[i].OlderThan(c[j]) }
+func (c Collection) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+var _ sort.Interface = Collection(nil)
+
+// Sort parses each of versions, returns them sorted in ascending order, and
+// reports any parse errors encountered (one per invalid entry, in input
+// order). The sort is stable, so entries of equal precedence (e.g. differing
+// only in build metadata, which is ignored for ordering) keep their relative
+// input order.
+func Sort(versions []string) ([]string, []error) {
+	var errs []error
+	parsed := make(Collection, 0, len(versions))
+	for _, version := range versions {
+		v, err := Parse(version)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		parsed = append(parsed, v)
+	}
+	sort.Stable(parsed)
+	out := make([]string, len(parsed))
+	for i, v := range parsed {
+		out[i] = v.ConvertToString()
+	}
+	return out, errs
+}
+
+// Latest returns the highest-precedence version in versions, prerelease or not.
+func Latest(versions []string) (string, error) {
+	return latestMatching(versions, func(Semver) bool { return true })
+}
+
+// LatestStable returns the highest-precedence version in versions, excluding
+// any with a prerelease tag or with major version 0.
+func LatestStable(versions []string) (string, error) {
+	return latestMatching(versions, func(v Semver) bool {
+		return len(v.Pre) == 0 && v.Major != 0
+	})
+}
+
+func latestMatching(versions []string, keep func(Semver) bool) (string, error) {
+	var best *Semver
+	for _, version := range versions {
+		v, err := Parse(version)
+		if err != nil {
+			continue
+		}
+		if !keep(v) {
+			continue
+		}
+		if best == nil || v.NewerThan(*best) {
+			best = &v
+		}
+	}
+	if best == nil {
+		return "", errors.New("semver: no matching version found")
+	}
+	return best.ConvertToString(), nil
+}
@@ -0,0 +1,79 @@
+package semver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	versions := []string{"1.3.0", "2.0.1", "1.3.0-alpha", "1.4.0"}
+	sorted, errs := Sort(versions)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	want := []string{"1.3.0-alpha", "1.3.0", "1.4.0", "2.0.1"}
+	if !reflect.DeepEqual(sorted, want) {
+		t.Errorf("Sort(%v) = %v, want %v", versions, sorted, want)
+	}
+}
+
+func TestSortInvalidEntries(t *testing.T) {
+	versions := []string{"1.2.3", "not-a-version", "1.2.4"}
+	sorted, errs := Sort(versions)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	want := []string{"1.2.3", "1.2.4"}
+	if !reflect.DeepEqual(sorted, want) {
+		t.Errorf("Sort(%v) = %v, want %v", versions, sorted, want)
+	}
+}
+
+func TestLatest(t *testing.T) {
+	versions := []string{"1.3.0", "2.0.1-beta", "1.4.0"}
+	latest, err := Latest(versions)
+	if err != nil {
+		t.Fatalf("Latest returned error: %v", err)
+	}
+	if latest != "2.0.1-beta" {
+		t.Errorf("Latest(%v) = %q, want %q", versions, latest, "2.0.1-beta")
+	}
+}
+
+func TestLatestStable(t *testing.T) {
+	versions := []string{"1.3.0", "2.0.1-beta", "1.4.0", "0.9.0"}
+	latest, err := LatestStable(versions)
+	if err != nil {
+		t.Fatalf("LatestStable returned error: %v", err)
+	}
+	if latest != "1.4.0" {
+		t.Errorf("LatestStable(%v) = %q, want %q", versions, latest, "1.4.0")
+	}
+}
+
+func TestLatestStableNoneFound(t *testing.T) {
+	versions := []string{"0.9.0", "1.0.0-alpha"}
+	if _, err := LatestStable(versions); err == nil {
+		t.Errorf("expected error when no stable version is present")
+	}
+}
+
+func TestSortIsStableAcrossEqualPrecedence(t *testing.T) {
+	versions := make([]string, 0, 32)
+	for i := 0; i < 30; i++ {
+		versions = append(versions, fmt.Sprintf("1.0.0+build.%d", i))
+	}
+	versions = append(versions, "0.9.0", "1.0.1")
+
+	sorted, errs := Sort(versions)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	want := append([]string{"0.9.0"}, versions[:30]...)
+	want = append(want, "1.0.1")
+	if !reflect.DeepEqual(sorted, want) {
+		t.Errorf("Sort did not preserve input order for equal-precedence entries:\ngot:  %v\nwant: %v", sorted, want)
+	}
+}
@@ -0,0 +1,76 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+/* These let Semver embed directly in API request/response structs and ORM
+ * models, round-tripping as a plain string through JSON, config files, and
+ * SQL columns.
+ */
+
+// MarshalJSON renders the Semver as its canonical quoted string form.
+func (ver Semver) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ver.ConvertToString())
+}
+
+// UnmarshalJSON parses a quoted version string, returning the same typed
+// errors as Parse (matchable with errors.Is) on failure.
+func (ver *Semver) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*ver = v
+	return nil
+}
+
+// MarshalText renders the Semver in its canonical string form.
+func (ver Semver) MarshalText() ([]byte, error) {
+	return []byte(ver.ConvertToString()), nil
+}
+
+// UnmarshalText parses a version string, returning the same typed errors as
+// Parse (matchable with errors.Is) on failure.
+func (ver *Semver) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*ver = v
+	return nil
+}
+
+// Value implements driver.Valuer so a Semver can be written to a SQL column
+// as its canonical string form.
+func (ver Semver) Value() (driver.Value, error) {
+	return ver.ConvertToString(), nil
+}
+
+// Scan implements sql.Scanner, accepting string or []byte column values.
+func (ver *Semver) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	case nil:
+		return errors.New("semver: cannot scan NULL into Semver")
+	default:
+		return fmt.Errorf("semver: cannot scan %T into Semver", src)
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*ver = v
+	return nil
+}
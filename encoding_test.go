@@ -0,0 +1,90 @@
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	v := MustParse("1.2.3-rc.1+build.5")
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+	if string(data) != `"1.2.3-rc.1+build.5"` {
+		t.Errorf("MarshalJSON = %s, want %q", data, "1.2.3-rc.1+build.5")
+	}
+	var out Semver
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("UnmarshalJSON returned error: %v", err)
+	}
+	if !out.EquivalentTo(v) || out.ConvertToString() != v.ConvertToString() {
+		t.Errorf("round-tripped version %s, want %s", out.ConvertToString(), v.ConvertToString())
+	}
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	var out Semver
+	err := json.Unmarshal([]byte(`"not-a-version"`), &out)
+	if err == nil {
+		t.Fatalf("expected error for invalid version string")
+	}
+	if !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("UnmarshalJSON error = %v, want it to wrap ErrInvalidVersion", err)
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	v := MustParse("2.3.4")
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned error: %v", err)
+	}
+	var out Semver
+	if err := out.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText returned error: %v", err)
+	}
+	if out.ConvertToString() != "2.3.4" {
+		t.Errorf("round-tripped version %s, want %s", out.ConvertToString(), "2.3.4")
+	}
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	var out Semver
+	err := out.UnmarshalText([]byte("1.09.0"))
+	if !errors.Is(err, ErrLeadingZero) {
+		t.Errorf("UnmarshalText error = %v, want it to wrap ErrLeadingZero", err)
+	}
+}
+
+func TestSQLValueAndScan(t *testing.T) {
+	v := MustParse("3.4.5")
+	value, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value returned error: %v", err)
+	}
+	var out Semver
+	if err := out.Scan(value); err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+	if out.ConvertToString() != "3.4.5" {
+		t.Errorf("scanned version %s, want %s", out.ConvertToString(), "3.4.5")
+	}
+
+	var fromBytes Semver
+	if err := fromBytes.Scan([]byte("3.4.5")); err != nil {
+		t.Fatalf("Scan from []byte returned error: %v", err)
+	}
+
+	var fromNil Semver
+	if err := fromNil.Scan(nil); err == nil {
+		t.Errorf("expected error scanning nil")
+	}
+
+	var fromInvalid Semver
+	err = fromInvalid.Scan("not-a-version")
+	if !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("Scan error = %v, want it to wrap ErrInvalidVersion", err)
+	}
+}
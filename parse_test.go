@@ -0,0 +1,79 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	v, err := Parse("1.3.0-rc.1+exp.sha.5114f85")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if v.Major != 1 || v.Minor != 3 || v.Patch != 0 {
+		t.Errorf("Parse core = %d.%d.%d, want 1.3.0", v.Major, v.Minor, v.Patch)
+	}
+	if !reflect.DeepEqual(v.Pre, []string{"rc", "1"}) {
+		t.Errorf("Parse Pre = %v, want [rc 1]", v.Pre)
+	}
+	if !reflect.DeepEqual(v.Build, []string{"exp", "sha", "5114f85"}) {
+		t.Errorf("Parse Build = %v, want [exp sha 5114f85]", v.Build)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		version string
+		target  error
+	}{
+		{"1.09.0", ErrLeadingZero},
+		{"1.2.3-$", ErrInvalidPrerelease},
+		{"1.2.3+ä", ErrInvalidBuild},
+		{"1.2", ErrInvalidVersion},
+		{"99999999999999999999.0.0", ErrInvalidVersion},
+	}
+	for _, c := range cases {
+		_, err := Parse(c.version)
+		if err == nil {
+			t.Errorf("Parse(%q) returned no error", c.version)
+			continue
+		}
+		if !errors.Is(err, c.target) {
+			t.Errorf("Parse(%q) error = %v, want it to wrap %v", c.version, err, c.target)
+		}
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse should have panicked on an invalid version")
+		}
+	}()
+	MustParse("not-a-version")
+}
+
+func TestValidate(t *testing.T) {
+	v := MustParse("1.2.3")
+	if err := v.Validate(); err != nil {
+		t.Errorf("Validate returned error for valid Semver: %v", err)
+	}
+	v.Pre = []string{"$"}
+	if err := v.Validate(); !errors.Is(err, ErrInvalidPrerelease) {
+		t.Errorf("Validate = %v, want it to wrap ErrInvalidPrerelease", err)
+	}
+}
+
+func TestConStructorDeprecatedWrapper(t *testing.T) {
+	v, errStr := ConStructor("1.2.3")
+	if errStr != "" {
+		t.Fatalf("ConStructor returned error: %s", errStr)
+	}
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 {
+		t.Errorf("ConStructor core = %d.%d.%d, want 1.2.3", v.Major, v.Minor, v.Patch)
+	}
+	if _, errStr := ConStructor("not-a-version"); errStr == "" {
+		t.Errorf("ConStructor should return an error message for an invalid version")
+	}
+}
@@ -0,0 +1,393 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/* Range implements the npm/Cargo-style version constraint grammar on top of
+ * the existing Semver comparison rules:
+ *   =, !=, <, <=, >, >=            simple comparators
+ *   1.2.3 - 2.3.4                  hyphenated range (inclusive)
+ *   ^1.2.3                         caret range (see caretRange)
+ *   ~1.2.3                         tilde range (see tildeRange)
+ *   1.2.x, 1.x, *                  x/X/* wildcards in any position
+ * Whitespace-separated terms within one expression are AND'd together;
+ * '||' separates alternative expressions that are OR'd together.
+ */
+
+// Comparison operators supported by a single Range predicate.
+const (
+	opEQ string = "="
+	opNE string = "!="
+	opLT string = "<"
+	opLE string = "<="
+	opGT string = ">"
+	opGE string = ">="
+)
+
+// comparator is a single operator/version predicate, e.g. ">=1.2.3".
+type comparator struct {
+	op  string
+	ver Semver
+}
+
+func (c comparator) matches(v Semver) bool {
+	switch c.op {
+	case opEQ:
+		return v.EquivalentTo(c.ver)
+	case opNE:
+		return !v.EquivalentTo(c.ver)
+	case opLT:
+		return v.OlderThan(c.ver)
+	case opLE:
+		return v.OlderThan(c.ver) || v.EquivalentTo(c.ver)
+	case opGT:
+		return v.NewerThan(c.ver)
+	case opGE:
+		return v.NewerThan(c.ver) || v.EquivalentTo(c.ver)
+	}
+	return false
+}
+
+func (c comparator) String() string {
+	return c.op + c.ver.ConvertToString()
+}
+
+// andClause is a group of comparators, all of which must match (every
+// whitespace-separated term of one '||'-separated alternative).
+type andClause []comparator
+
+func (a andClause) matches(v Semver) bool {
+	if len(v.Pre) > 0 {
+		named := false
+		for _, c := range a {
+			if len(c.ver.Pre) > 0 && c.ver.Major == v.Major && c.ver.Minor == v.Minor && c.ver.Patch == v.Patch {
+				named = true
+				break
+			}
+		}
+		if !named {
+			return false
+		}
+	}
+	for _, c := range a {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a andClause) String() string {
+	parts := make([]string, len(a))
+	for i, c := range a {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// Range is a constraint expression compiled down to a slice of AND-clauses
+// that are OR'd together, ready for repeated matching against Semver values.
+type Range struct {
+	clauses []andClause
+}
+
+// ParseRange compiles a constraint expression such as "^1.2.3 || 2.0.0 - 2.1.0"
+// into a Range.
+func ParseRange(expr string) (Range, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Range{}, errors.New("semver: empty range expression")
+	}
+	orParts := strings.Split(expr, "||")
+	clauses := make([]andClause, 0, len(orParts))
+	for _, part := range orParts {
+		clause, err := parseAndClause(part)
+		if err != nil {
+			return Range{}, err
+		}
+		clauses = append(clauses, clause)
+	}
+	return Range{clauses: clauses}, nil
+}
+
+// Matches reports whether v satisfies any of the Range's OR'd AND-clauses.
+func (r Range) Matches(v Semver) bool {
+	for _, clause := range r.clauses {
+		if clause.matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the normalized, compiled form of the range.
+func (r Range) String() string {
+	parts := make([]string, len(r.clauses))
+	for i, c := range r.clauses {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+// Satisfies is a convenience wrapper that parses both arguments and reports
+// whether version matches constraint. It returns false if either fails to parse.
+func Satisfies(version, constraint string) bool {
+	v, err := Parse(version)
+	if err != nil {
+		return false
+	}
+	r, err := ParseRange(constraint)
+	if err != nil {
+		return false
+	}
+	return r.Matches(v)
+}
+
+var rxHyphenRange = regexp.MustCompile(`^\s*(\S+)\s+-\s+(\S+)\s*$`)
+var rxOperator = regexp.MustCompile(`^(=|!=|<=|>=|<|>)`)
+
+func parseAndClause(part string) (andClause, error) {
+	part = strings.TrimSpace(part)
+	if part == "" {
+		return nil, errors.New("semver: empty range term")
+	}
+	if m := rxHyphenRange.FindStringSubmatch(part); m != nil {
+		return hyphenRange(m[1], m[2])
+	}
+	var clause andClause
+	for _, term := range strings.Fields(part) {
+		comps, err := compileTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		clause = append(clause, comps...)
+	}
+	return clause, nil
+}
+
+func compileTerm(term string) ([]comparator, error) {
+	switch {
+	case strings.HasPrefix(term, "^"):
+		return caretRange(term[1:])
+	case strings.HasPrefix(term, "~"):
+		return tildeRange(term[1:])
+	}
+	op := opEQ
+	rest := term
+	if m := rxOperator.FindString(term); m != "" {
+		op = m
+		rest = term[len(m):]
+	}
+	if rest == "" {
+		return nil, fmt.Errorf("semver: missing version in range term %q", term)
+	}
+	if hasWildcard(rest) {
+		if op != opEQ {
+			return nil, fmt.Errorf("semver: operator %q cannot be combined with a wildcard version in range term %q", op, term)
+		}
+		return wildcardRange(rest)
+	}
+	v, err := Parse(rest)
+	if err != nil {
+		return nil, fmt.Errorf("semver: invalid version in range term %q: %w", term, err)
+	}
+	return []comparator{{op: op, ver: v}}, nil
+}
+
+func isWildcard(s string) bool {
+	return s == "" || s == "x" || s == "X" || s == "*"
+}
+
+func hasWildcard(s string) bool {
+	parts := strings.SplitN(coreVersion(s), ".", 3)
+	if len(parts) < 3 {
+		return true
+	}
+	for _, p := range parts {
+		if isWildcard(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// coreVersion strips any prerelease/build suffix so positional checks only
+// look at the major.minor.patch portion.
+func coreVersion(s string) string {
+	if idx := strings.IndexAny(s, "-+"); idx >= 0 {
+		return s[:idx]
+	}
+	return s
+}
+
+// parsePartial parses a version that may omit minor/patch or use wildcards
+// in a trailing position, filling the missing components with zero.
+func parsePartial(s string) (Semver, error) {
+	s = strings.TrimSpace(s)
+	if IsValid(s) {
+		v, err := Parse(s)
+		if err != nil {
+			return Semver{}, err
+		}
+		return v, nil
+	}
+	parts := strings.Split(coreVersion(s), ".")
+	var nums [3]uint64
+	for i := 0; i < 3; i++ {
+		if i >= len(parts) || isWildcard(parts[i]) {
+			break
+		}
+		n, err := strconv.ParseUint(parts[i], 10, 64)
+		if err != nil {
+			return Semver{}, fmt.Errorf("semver: invalid version in range: %q", s)
+		}
+		nums[i] = n
+	}
+	return Semver{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// wildcardRange expands a bare (operator-less, or explicitly "=") x/X/*-
+// containing term into the >= / < comparator pair it denotes, e.g. "1.2.x"
+// -> >=1.2.0 <1.3.0, "1.x" -> >=1.0.0 <2.0.0, "x" / "*" -> matches anything.
+// Combining a wildcard with any other comparison operator is rejected by
+// compileTerm before this is called.
+func wildcardRange(s string) ([]comparator, error) {
+	parts := strings.Split(coreVersion(s), ".")
+	wildAt := len(parts)
+	for i := 0; i < 3 && i < len(parts); i++ {
+		if isWildcard(parts[i]) {
+			wildAt = i
+			break
+		}
+	}
+	if wildAt == 0 {
+		return []comparator{{op: opGE, ver: Semver{}}}, nil
+	}
+	var nums [3]uint64
+	for i := 0; i < wildAt; i++ {
+		n, err := strconv.ParseUint(parts[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("semver: invalid version in range term %q", s)
+		}
+		nums[i] = n
+	}
+	lo := Semver{Major: nums[0], Minor: nums[1], Patch: nums[2]}
+	var hi Semver
+	if wildAt == 1 {
+		hi = Semver{Major: lo.Major + 1}
+	} else {
+		hi = Semver{Major: lo.Major, Minor: lo.Minor + 1}
+	}
+	return []comparator{{op: opGE, ver: lo}, {op: opLT, ver: hi}}, nil
+}
+
+// caretRange implements `^`: allow changes that do not modify the
+// left-most non-zero component (with the usual 0.x/0.0.x carve-outs).
+func caretRange(s string) ([]comparator, error) {
+	parts := strings.Split(coreVersion(s), ".")
+	wildAt := len(parts)
+	for i := 0; i < 3 && i < len(parts); i++ {
+		if isWildcard(parts[i]) {
+			wildAt = i
+			break
+		}
+	}
+	if wildAt == 0 {
+		return []comparator{{op: opGE, ver: Semver{}}}, nil
+	}
+	lo, err := parsePartial(s)
+	if err != nil {
+		return nil, err
+	}
+	var hi Semver
+	switch {
+	case lo.Major > 0:
+		hi = Semver{Major: lo.Major + 1}
+	case wildAt <= 1:
+		hi = Semver{Major: 1}
+	case lo.Minor > 0:
+		hi = Semver{Minor: lo.Minor + 1}
+	case wildAt <= 2:
+		hi = Semver{Minor: 1}
+	case lo.Patch > 0:
+		hi = Semver{Patch: lo.Patch + 1}
+	default:
+		hi = Semver{Patch: 1}
+	}
+	return []comparator{{op: opGE, ver: lo}, {op: opLT, ver: hi}}, nil
+}
+
+// tildeRange implements `~`: allow patch-level changes if minor is given,
+// otherwise allow minor-level changes.
+func tildeRange(s string) ([]comparator, error) {
+	parts := strings.Split(coreVersion(s), ".")
+	lo, err := parsePartial(s)
+	if err != nil {
+		return nil, err
+	}
+	var hi Semver
+	if len(parts) >= 2 && !isWildcard(parts[1]) {
+		hi = Semver{Major: lo.Major, Minor: lo.Minor + 1}
+	} else {
+		hi = Semver{Major: lo.Major + 1}
+	}
+	return []comparator{{op: opGE, ver: lo}, {op: opLT, ver: hi}}, nil
+}
+
+func hyphenRange(lo, hi string) (andClause, error) {
+	loVer, err := parsePartial(lo)
+	if err != nil {
+		return nil, err
+	}
+	hiVer, hiExact, err := parseHyphenUpper(hi)
+	if err != nil {
+		return nil, err
+	}
+	op := opLE
+	if !hiExact {
+		op = opLT
+	}
+	return andClause{
+		{op: opGE, ver: loVer},
+		{op: op, ver: hiVer},
+	}, nil
+}
+
+// parseHyphenUpper parses the upper bound of a hyphenated range. A fully
+// specified version is an inclusive upper bound; a partial one (e.g. "2.3")
+// is exclusive of the next component ("2.3" -> <2.4.0).
+func parseHyphenUpper(s string) (Semver, bool, error) {
+	s = strings.TrimSpace(s)
+	if IsValid(s) {
+		v, err := Parse(s)
+		if err != nil {
+			return Semver{}, false, err
+		}
+		return v, true, nil
+	}
+	parts := strings.Split(coreVersion(s), ".")
+	switch {
+	case len(parts) >= 3 && !isWildcard(parts[2]):
+		v, err := parsePartial(s)
+		return v, true, err
+	case len(parts) >= 2 && !isWildcard(parts[1]):
+		maj, err1 := strconv.ParseUint(parts[0], 10, 64)
+		min, err2 := strconv.ParseUint(parts[1], 10, 64)
+		if err1 != nil || err2 != nil {
+			return Semver{}, false, fmt.Errorf("semver: invalid version in range: %q", s)
+		}
+		return Semver{Major: maj, Minor: min + 1}, false, nil
+	case len(parts) >= 1 && !isWildcard(parts[0]):
+		maj, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return Semver{}, false, fmt.Errorf("semver: invalid version in range: %q", s)
+		}
+		return Semver{Major: maj + 1}, false, nil
+	}
+	return Semver{}, false, fmt.Errorf("semver: invalid version in range: %q", s)
+}
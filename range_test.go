@@ -0,0 +1,147 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestSatisfiesSimpleOperators(t *testing.T) {
+	cases := []struct {
+		version, constraint string
+		want                bool
+	}{
+		{"1.2.3", "=1.2.3", true},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "!=1.2.3", false},
+		{"1.2.4", "!=1.2.3", true},
+		{"1.2.3", "<1.2.4", true},
+		{"1.2.4", "<1.2.4", false},
+		{"1.2.4", "<=1.2.4", true},
+		{"1.2.5", ">1.2.4", true},
+		{"1.2.4", ">=1.2.4", true},
+	}
+	for _, c := range cases {
+		got := Satisfies(c.version, c.constraint)
+		if got != c.want {
+			t.Errorf("Satisfies(%q, %q) = %v, want %v", c.version, c.constraint, got, c.want)
+		}
+	}
+}
+
+func TestSatisfiesAndOr(t *testing.T) {
+	if !Satisfies("1.2.5", ">=1.2.0 <1.3.0") {
+		t.Errorf("1.2.5 should satisfy >=1.2.0 <1.3.0")
+	}
+	if Satisfies("1.3.0", ">=1.2.0 <1.3.0") {
+		t.Errorf("1.3.0 should not satisfy >=1.2.0 <1.3.0")
+	}
+	if !Satisfies("2.0.0", "1.x || ^2.0.0") {
+		t.Errorf("2.0.0 should satisfy 1.x || ^2.0.0")
+	}
+	if Satisfies("3.0.0", "1.x || ^2.0.0") {
+		t.Errorf("3.0.0 should not satisfy 1.x || ^2.0.0")
+	}
+}
+
+func TestSatisfiesHyphenRange(t *testing.T) {
+	if !Satisfies("1.2.3", "1.2.3 - 2.3.4") {
+		t.Errorf("1.2.3 should satisfy 1.2.3 - 2.3.4")
+	}
+	if !Satisfies("2.3.4", "1.2.3 - 2.3.4") {
+		t.Errorf("2.3.4 should satisfy 1.2.3 - 2.3.4")
+	}
+	if Satisfies("2.3.5", "1.2.3 - 2.3.4") {
+		t.Errorf("2.3.5 should not satisfy 1.2.3 - 2.3.4")
+	}
+	if !Satisfies("2.3.99", "1.2.3 - 2.3") {
+		t.Errorf("2.3.99 should satisfy partial upper bound 1.2.3 - 2.3")
+	}
+	if Satisfies("2.4.0", "1.2.3 - 2.3") {
+		t.Errorf("2.4.0 should not satisfy partial upper bound 1.2.3 - 2.3")
+	}
+}
+
+func TestSatisfiesCaret(t *testing.T) {
+	cases := []struct {
+		version, constraint string
+		want                bool
+	}{
+		{"1.2.9", "^1.2.3", true},
+		{"1.3.0", "^1.2.3", true},
+		{"2.0.0", "^1.2.3", false},
+		{"0.2.9", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"0.0.9", "^0.0.3", false},
+		{"0.0.3", "^0.0.3", true},
+		{"1.9.0", "^1.2.x", true},
+		{"2.0.0", "^1.2.x", false},
+	}
+	for _, c := range cases {
+		got := Satisfies(c.version, c.constraint)
+		if got != c.want {
+			t.Errorf("Satisfies(%q, %q) = %v, want %v", c.version, c.constraint, got, c.want)
+		}
+	}
+}
+
+func TestSatisfiesTilde(t *testing.T) {
+	cases := []struct {
+		version, constraint string
+		want                bool
+	}{
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"1.3.5", "~1.3", true},
+		{"1.4.0", "~1.3", false},
+		{"1.9.9", "~1", true},
+		{"2.0.0", "~1", false},
+	}
+	for _, c := range cases {
+		got := Satisfies(c.version, c.constraint)
+		if got != c.want {
+			t.Errorf("Satisfies(%q, %q) = %v, want %v", c.version, c.constraint, got, c.want)
+		}
+	}
+}
+
+func TestSatisfiesPrereleaseExclusion(t *testing.T) {
+	if Satisfies("1.2.3-alpha", ">=1.0.0 <2.0.0") {
+		t.Errorf("prerelease should not match a range that doesn't name it explicitly")
+	}
+	if !Satisfies("1.2.3-alpha", ">=1.2.3-alpha <1.3.0") {
+		t.Errorf("prerelease should match a range naming the same major.minor.patch with a prerelease tag")
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	r, err := ParseRange("^1.2.3 || ~2.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+	want := ">=1.2.3 <2.0.0 || >=2.0.0 <2.1.0"
+	if got := r.String(); got != want {
+		t.Errorf("Range.String() = %q, want %q", got, want)
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	if _, err := ParseRange(""); err == nil {
+		t.Errorf("expected error for empty range expression")
+	}
+	if _, err := ParseRange("not-a-version"); err == nil {
+		t.Errorf("expected error for invalid range expression")
+	}
+}
+
+func TestWildcardRejectsOperators(t *testing.T) {
+	for _, expr := range []string{"!=1.2.x", ">1.2.x", "<1.2.x", ">=1.x", "<=*"} {
+		if _, err := ParseRange(expr); err == nil {
+			t.Errorf("ParseRange(%q) should reject an operator combined with a wildcard", expr)
+		}
+		if Satisfies("1.2.5", expr) {
+			t.Errorf("Satisfies(%q, %q) should be false, not silently ignore the operator", "1.2.5", expr)
+		}
+	}
+	if !Satisfies("1.2.5", "=1.2.x") {
+		t.Errorf("an explicit = operator should still combine with a wildcard")
+	}
+}
@@ -0,0 +1,95 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/* Recommend gives release automation a primitive like what gorelease computes
+ * from an apidiff: feed it a base version and a summary of what changed, and
+ * it returns the smallest next version that respects semver.
+ */
+
+// Changes summarizes the externally observable differences between a base
+// version and a proposed release, as produced by an API-diff report.
+type Changes struct {
+	Breaking      bool
+	Additive      bool
+	Fixes         bool
+	PrereleaseTag string
+}
+
+// PRERELEASE selects the incrementVersion/Increment variant that bumps only
+// the trailing numeric identifier of a prerelease tag in place.
+const PRERELEASE string = "prerelease"
+
+// Recommend returns the smallest next version after base that respects c.
+// Breaking changes bump MAJOR, Additive changes bump MINOR, and Fixes bump
+// PATCH. While base.Major == 0 ("anything may change"), Breaking changes
+// only bump MINOR and Additive/Fixes changes only bump PATCH. If
+// PrereleaseTag is set, it's appended to the result; when base already
+// carries the same tag at the same major.minor.patch, its trailing numeric
+// identifier is incremented instead (e.g. "1.2.0-rc.1" -> "1.2.0-rc.2").
+func Recommend(base Semver, c Changes) (Semver, error) {
+	next := base
+	switch {
+	case c.Breaking:
+		if base.Major == 0 {
+			next.incrementVersion(MINOR)
+		} else {
+			next.incrementVersion(MAJOR)
+		}
+		next.Pre, next.Build = nil, nil
+	case c.Additive:
+		if base.Major == 0 {
+			next.incrementVersion(PATCH)
+		} else {
+			next.incrementVersion(MINOR)
+		}
+		next.Pre, next.Build = nil, nil
+	case c.Fixes:
+		next.incrementVersion(PATCH)
+		next.Pre, next.Build = nil, nil
+	}
+
+	if c.PrereleaseTag == "" {
+		return next, nil
+	}
+
+	sameCore := next.Major == base.Major && next.Minor == base.Minor && next.Patch == base.Patch
+	if sameCore && len(base.Pre) > 0 && base.Pre[0] == c.PrereleaseTag {
+		last := base.Pre[len(base.Pre)-1]
+		if !rxNumeric.MatchString(last) {
+			return Semver{}, fmt.Errorf("semver: prerelease tag %q in %q has no numeric identifier to increment", c.PrereleaseTag, base.ConvertToString())
+		}
+		n, err := strconv.ParseUint(last, 10, 64)
+		if err != nil {
+			return Semver{}, fmt.Errorf("semver: prerelease tag %q in %q has a numeric identifier too large to increment", c.PrereleaseTag, base.ConvertToString())
+		}
+		next.Pre = append([]string(nil), base.Pre...)
+		next.Pre[len(next.Pre)-1] = strconv.FormatUint(n+1, 10)
+		next.Build = nil
+		return next, nil
+	}
+	next.Pre = []string{c.PrereleaseTag}
+	next.Build = nil
+	return next, nil
+}
+
+// bumpPrereleaseTail increments the trailing numeric identifier of a's
+// prerelease tag in place. It's a no-op if a has no prerelease tag or the
+// tag's last identifier isn't numeric.
+func (a *Semver) bumpPrereleaseTail() {
+	if len(a.Pre) == 0 {
+		return
+	}
+	last := a.Pre[len(a.Pre)-1]
+	if !rxNumeric.MatchString(last) {
+		return
+	}
+	n, err := strconv.ParseUint(last, 10, 64)
+	if err != nil {
+		return
+	}
+	a.Pre[len(a.Pre)-1] = strconv.FormatUint(n+1, 10)
+}
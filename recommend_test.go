@@ -0,0 +1,90 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestRecommendBumpsByPrecedence(t *testing.T) {
+	base := MustParse("1.2.3")
+	cases := []struct {
+		name    string
+		changes Changes
+		want    string
+	}{
+		{"breaking wins over additive and fixes", Changes{Breaking: true, Additive: true, Fixes: true}, "2.0.0"},
+		{"additive wins over fixes", Changes{Additive: true, Fixes: true}, "1.3.0"},
+		{"fixes alone", Changes{Fixes: true}, "1.2.4"},
+		{"no changes", Changes{}, "1.2.3"},
+	}
+	for _, c := range cases {
+		got, err := Recommend(base, c.changes)
+		if err != nil {
+			t.Fatalf("%s: Recommend returned error: %v", c.name, err)
+		}
+		if got.ConvertToString() != c.want {
+			t.Errorf("%s: Recommend = %s, want %s", c.name, got.ConvertToString(), c.want)
+		}
+	}
+}
+
+func TestRecommendZeroMajorCarveOut(t *testing.T) {
+	base := MustParse("0.4.2")
+	got, err := Recommend(base, Changes{Breaking: true})
+	if err != nil {
+		t.Fatalf("Recommend returned error: %v", err)
+	}
+	if got.ConvertToString() != "0.5.0" {
+		t.Errorf("Breaking change on 0.y.z = %s, want 0.5.0", got.ConvertToString())
+	}
+
+	got, err = Recommend(base, Changes{Additive: true})
+	if err != nil {
+		t.Fatalf("Recommend returned error: %v", err)
+	}
+	if got.ConvertToString() != "0.4.3" {
+		t.Errorf("Additive change on 0.y.z = %s, want 0.4.3", got.ConvertToString())
+	}
+}
+
+func TestRecommendPrereleaseTag(t *testing.T) {
+	base := MustParse("1.2.3")
+	got, err := Recommend(base, Changes{Fixes: true, PrereleaseTag: "rc"})
+	if err != nil {
+		t.Fatalf("Recommend returned error: %v", err)
+	}
+	if got.ConvertToString() != "1.2.4-rc" {
+		t.Errorf("Recommend with fresh tag = %s, want 1.2.4-rc", got.ConvertToString())
+	}
+}
+
+func TestRecommendPrereleaseIncrement(t *testing.T) {
+	base := MustParse("1.2.0-rc.1")
+	got, err := Recommend(base, Changes{PrereleaseTag: "rc"})
+	if err != nil {
+		t.Fatalf("Recommend returned error: %v", err)
+	}
+	if got.ConvertToString() != "1.2.0-rc.2" {
+		t.Errorf("Recommend with matching tag = %s, want 1.2.0-rc.2", got.ConvertToString())
+	}
+}
+
+func TestRecommendPrereleaseNoNumericTail(t *testing.T) {
+	base := MustParse("1.2.0-rc")
+	if _, err := Recommend(base, Changes{PrereleaseTag: "rc"}); err == nil {
+		t.Errorf("expected error incrementing a prerelease tag with no numeric tail")
+	}
+}
+
+func TestRecommendPrereleaseOverflow(t *testing.T) {
+	base := MustParse("1.2.0-rc.99999999999999999999")
+	if _, err := Recommend(base, Changes{PrereleaseTag: "rc"}); err == nil {
+		t.Errorf("expected error incrementing a prerelease tag whose numeric tail overflows uint64")
+	}
+}
+
+func TestIncrementPrerelease(t *testing.T) {
+	got := Increment("1.2.0-rc.1", PRERELEASE)
+	if got != "1.2.0-rc.2" {
+		t.Errorf("Increment(_, PRERELEASE) = %s, want 1.2.0-rc.2", got)
+	}
+}
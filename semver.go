@@ -1,6 +1,8 @@
 package semver
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -14,40 +16,24 @@ import (
 // This is the current version of this package
 var Version = "2.0.0-alpha.0.1.1"
 
-type PrereleaseTag struct {
-	tag string
-}
-
-func makePrerelease(tag string) (PrereleaseTag, string) {
-	if rxPre.MatchString(tag) {
-		return PrereleaseTag{tag: tag}, ""
-	} else {
-		return PrereleaseTag{}, "Invalid prerelease tag"
-	}
-}
-
-type BuildTag struct {
-	tag string
-}
-
-func makeBuild(tag string) (BuildTag, string) {
-	if rxBuild.MatchString(tag) {
-		return BuildTag{tag: tag}, ""
-	} else {
-		return BuildTag{}, "Invalid build tag"
-	}
-}
+// Sentinel errors, usable with errors.Is, describing why a version string
+// failed to parse.
+var (
+	ErrInvalidVersion    = errors.New("semver: invalid version")
+	ErrInvalidPrerelease = errors.New("semver: invalid prerelease identifier")
+	ErrInvalidBuild      = errors.New("semver: invalid build identifier")
+	ErrLeadingZero       = errors.New("semver: numeric identifier has a leading zero")
+)
 
-/* Struct for semver string comprehension and manipulation.
- * This type and the methods associated are meant only for internal use,
- * and they have been written only with the intention of making the
- * API work easier to comprehend.
+/* Semver holds the parsed components of a semantic version string.
+ * Pre and Build are the dot-separated identifiers of the prerelease and
+ * build metadata sections, in order, with no entries when absent.
  */
 
 type Semver struct {
-	major, minor, patch uint64
-	pre                 PrereleaseTag
-	build               BuildTag
+	Major, Minor, Patch uint64
+	Pre                 []string
+	Build               []string
 }
 
 const (
@@ -84,49 +70,110 @@ var rxMatch, _ = regexp.Compile("^(0|[1-9]\\d*)\\.(0|[1-9]\\d*)\\.(0|[1-9]\\d*)(
 var rxNumeric, _ = regexp.Compile("^(0|[1-9])+$")                                                                                 // For checking pre-release identifiers to see if they are pure numeric
 var rxPre, _ = regexp.Compile("^((?:0|[1-9]\\d*|\\d*[a-zA-Z-][a-zA-Z0-9-]*)(?:\\.(?:0|[1-9]\\d*|\\d*[a-zA-Z-][a-zA-Z0-9-]*))*)$") // The rules for a pre-release string, omitting the leading dash
 var rxBuild, _ = regexp.Compile("^(?:[0-9A-Za-z-]+(?:\\.[0-9A-Za-z-]+)*)$")                                                       // The rules for a build string, omitting the leading plus
+var rxLeadingZero, _ = regexp.Compile("^0[0-9]+$")                                                                                // A numeric component with a disallowed leading zero
 
-// Return in the same format as provided, when applicable
+// ConvertToString renders the Semver back into its canonical string form.
 func (ver Semver) ConvertToString() string {
-	version := strings.Join([]string{strconv.FormatUint(ver.major, 10), strconv.FormatUint(ver.minor, 10), strconv.FormatUint(ver.patch, 10)}, ".")
-	if len(ver.pre.tag) > 0 {
-		version = strings.Join([]string{version, ver.pre.tag}, "-")
+	version := strings.Join([]string{strconv.FormatUint(ver.Major, 10), strconv.FormatUint(ver.Minor, 10), strconv.FormatUint(ver.Patch, 10)}, ".")
+	if len(ver.Pre) > 0 {
+		version = strings.Join([]string{version, strings.Join(ver.Pre, ".")}, "-")
 	}
-	if len(ver.build.tag) > 0 {
-		version = strings.Join([]string{version, ver.build.tag}, "+")
+	if len(ver.Build) > 0 {
+		version = strings.Join([]string{version, strings.Join(ver.Build, ".")}, "+")
 	}
 	return version
 }
 
-// Puntastic function to make a struct from a version string
-// This makes it easier to deal with various parts
-func ConStructor(version string) (*Semver, string) {
-	if !IsValid(version) {
-		return &Semver{}, "Not a valid version string"
-	}
-	var ver, err, bd, rl, a, b, c string
-	var bld BuildTag
-	var rel PrereleaseTag
-	ver = version
-	if strings.Index(version, "+") > -1 {
-		ver, bd = extractor(version, "+")
-		bld, err = makeBuild(bd)
-		if len(err) > 0 {
-			return &Semver{}, "Not a valid build string"
-		}
+// String implements fmt.Stringer in terms of ConvertToString.
+func (ver Semver) String() string {
+	return ver.ConvertToString()
+}
+
+// Parse parses a strict semver 2.0.0 string into a Semver, returning a
+// sentinel error (ErrInvalidVersion, ErrInvalidPrerelease, ErrInvalidBuild,
+// or ErrLeadingZero) wrapped with the offending input when it doesn't
+// validate.
+func Parse(version string) (Semver, error) {
+	if !rxMatch.MatchString(version) {
+		return Semver{}, diagnose(version)
+	}
+	ver := version
+	var buildStr, preStr string
+	if strings.Index(ver, "+") > -1 {
+		ver, buildStr = extractor(ver, "+")
 	}
 	if strings.Index(ver, "-") > -1 {
-		ver, rl = extractor(ver, "-")
-		rel, err = makePrerelease(rl)
-		if len(err) > 0 {
-			return &Semver{}, "Not a valid prerelease string"
+		ver, preStr = extractor(ver, "-")
+	}
+	a, ver := extractor(ver, ".")
+	b, c := extractor(ver, ".")
+	maj, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return Semver{}, fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	min, err := strconv.ParseUint(b, 10, 64)
+	if err != nil {
+		return Semver{}, fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	pat, err := strconv.ParseUint(c, 10, 64)
+	if err != nil {
+		return Semver{}, fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	var pre, build []string
+	if len(preStr) > 0 {
+		pre = strings.Split(preStr, ".")
+	}
+	if len(buildStr) > 0 {
+		build = strings.Split(buildStr, ".")
+	}
+	return Semver{Major: maj, Minor: min, Patch: pat, Pre: pre, Build: build}, nil
+}
+
+// MustParse is like Parse but panics if version fails to parse. It's meant
+// for tests and package-level version constants, not for handling input.
+func MustParse(version string) Semver {
+	v, err := Parse(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate reports whether ver's fields describe a valid semver version,
+// returning the same sentinel errors as Parse.
+func (ver Semver) Validate() error {
+	_, err := Parse(ver.ConvertToString())
+	return err
+}
+
+// diagnose figures out which specific rule an invalid version string broke,
+// for callers of Parse that want a typed reason rather than a bare no-match.
+func diagnose(version string) error {
+	rest := version
+	if strings.Index(rest, "+") > -1 {
+		var bd string
+		rest, bd = extractor(rest, "+")
+		if !rxBuild.MatchString(bd) {
+			return fmt.Errorf("%w: %q", ErrInvalidBuild, version)
 		}
 	}
-	a, ver = extractor(ver, ".")
-	b, c = extractor(ver, ".")
-	maj, _ := strconv.ParseUint(a, 10, 0)
-	min, _ := strconv.ParseUint(b, 10, 0)
-	pat, _ := strconv.ParseUint(c, 10, 0)
-	return &Semver{major: maj, minor: min, patch: pat, pre: rel, build: bld}, ""
+	if strings.Index(rest, "-") > -1 {
+		var pre string
+		rest, pre = extractor(rest, "-")
+		if !rxPre.MatchString(pre) {
+			return fmt.Errorf("%w: %q", ErrInvalidPrerelease, version)
+		}
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	for _, p := range parts {
+		if rxLeadingZero.MatchString(p) {
+			return fmt.Errorf("%w: %q", ErrLeadingZero, version)
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidVersion, version)
 }
 
 // Helper to do tediously repetitive slicing of strings
@@ -139,15 +186,15 @@ func extractor(base, mark string) (string, string) {
 // Compare normal version string to see if 'a' is older than 'b'
 // If normal version is entirely the same, compare pre-release strings
 func (a Semver) OlderThan(b Semver) bool {
-	if a.major < b.major {
+	if a.Major < b.Major {
 		return true
-	} else if a.major == b.major {
-		if a.minor < b.minor {
+	} else if a.Major == b.Major {
+		if a.Minor < b.Minor {
 			return true
-		} else if a.minor == b.minor {
-			if a.patch < b.patch {
+		} else if a.Minor == b.Minor {
+			if a.Patch < b.Patch {
 				return true
-			} else if a.patch == b.patch {
+			} else if a.Patch == b.Patch {
 				return b.edgierThan(a)
 			}
 		}
@@ -158,15 +205,15 @@ func (a Semver) OlderThan(b Semver) bool {
 // Compare normal version string to see if 'a' is newer than 'b'
 // If normal version is entirely the same, compare pre-release strings
 func (a Semver) NewerThan(b Semver) bool {
-	if a.major > b.major {
+	if a.Major > b.Major {
 		return true
-	} else if a.major == b.major {
-		if a.minor > b.minor {
+	} else if a.Major == b.Major {
+		if a.Minor > b.Minor {
 			return true
-		} else if a.minor == b.minor {
-			if a.patch > b.patch {
+		} else if a.Minor == b.Minor {
+			if a.Patch > b.Patch {
 				return true
-			} else if a.patch == b.patch {
+			} else if a.Patch == b.Patch {
 				return a.edgierThan(b)
 			}
 		}
@@ -179,27 +226,25 @@ func (a Semver) EquivalentTo(b Semver) bool {
 	return a.OlderThan(b) == a.NewerThan(b)
 }
 
-// Determines if a's pre-release string is higher precedence than b's
+// Determines if a's pre-release identifiers are higher precedence than b's
 func (a Semver) edgierThan(b Semver) bool {
-	if len(a.pre.tag) == 0 || len(b.pre.tag) == 0 {
+	if len(a.Pre) == 0 || len(b.Pre) == 0 {
 		// Not having a pre-release string signifies precedence
-		return a.pre.tag < b.pre.tag
+		return len(a.Pre) == 0 && len(b.Pre) > 0
 	}
-	ed := strings.Split(a.pre.tag, ".")
-	gy := strings.Split(b.pre.tag, ".")
-	for key := range ed {
-		if len(gy) < key+1 {
+	for key := range a.Pre {
+		if len(b.Pre) < key+1 {
 			return true
 		}
-		if rxNumeric.MatchString(ed[key]) && rxNumeric.MatchString(gy[key]) {
-			if ed[key] != gy[key] {
-				left, _ := strconv.ParseInt(ed[key], 10, 0)
-				right, _ := strconv.ParseInt(gy[key], 10, 0)
+		if rxNumeric.MatchString(a.Pre[key]) && rxNumeric.MatchString(b.Pre[key]) {
+			if a.Pre[key] != b.Pre[key] {
+				left, _ := strconv.ParseInt(a.Pre[key], 10, 0)
+				right, _ := strconv.ParseInt(b.Pre[key], 10, 0)
 				return left > right
 			}
 		}
-		if ed[key] != gy[key] {
-			return ed[key] > gy[key]
+		if a.Pre[key] != b.Pre[key] {
+			return a.Pre[key] > b.Pre[key]
 		}
 	}
 	return false
@@ -209,13 +254,15 @@ func (a Semver) edgierThan(b Semver) bool {
 func (a *Semver) incrementVersion(enum string) {
 	switch enum {
 	case "major":
-		a.major += 1
-		a.minor, a.patch = 0, 0
+		a.Major += 1
+		a.Minor, a.Patch = 0, 0
 	case "minor":
-		a.minor += 1
-		a.patch = 0
+		a.Minor += 1
+		a.Patch = 0
 	case "patch":
-		a.patch += 1
+		a.Patch += 1
+	case PRERELEASE:
+		a.bumpPrereleaseTail()
 	}
 
 }
@@ -228,6 +275,25 @@ func IsValid(version string) bool {
 	return rxMatch.MatchString(version)
 }
 
+// ConStructor parses version into a *Semver, returning a bare error-message
+// string on failure instead of an error value.
+//
+// Deprecated: use Parse, which returns a typed error you can inspect with
+// errors.Is, instead of a bare error-message string.
+func ConStructor(version string) (*Semver, string) {
+	v, err := Parse(version)
+	if err != nil {
+		return &Semver{}, err.Error()
+	}
+	return &v, ""
+}
+
+// Increment bumps version's PATCH, MINOR, or MAJOR component (or, with
+// PRERELEASE, the trailing numeric identifier of its prerelease tag) and
+// returns the new version string, or "Invalid Version" if version doesn't parse.
+//
+// Deprecated: use Recommend for release automation, or mutate the exported
+// Major/Minor/Patch fields directly.
 func Increment(version, enum string) string {
 	if IsValid(version) {
 		a, _ := ConStructor(version)
@@ -237,7 +303,10 @@ func Increment(version, enum string) string {
 	return "Invalid Version"
 }
 
-// Returns true if newer, false if not OR if either input isn't a valid semver
+// IsNewer returns true if newer, false if not OR if either input isn't a
+// valid semver.
+//
+// Deprecated: use Parse and (Semver).NewerThan instead.
 func IsNewer(s, v string) bool {
 	if IsValid(s) && IsValid(v) {
 		a, _ := ConStructor(s)
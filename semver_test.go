@@ -1,87 +1,96 @@
 package semver
 
 import (
+	"reflect"
 	"testing"
 )
 
 func TestConStructor(t *testing.T) {
+	// ConStructor is now a thin wrapper over Parse, so these cases check Parse
+	// directly; the wrapper itself is covered by TestConStructorDeprecatedWrapper.
 	var ver1 = "3.6.1"
-	v1 := ConStructor(ver1)
-	var result = (v1.major == "3" && v1.minor == "6" && v1.patch == "1")
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver1, v1)
+	v1, err := Parse(ver1)
+	if err != nil {
+		t.Fatalf("Parse failed on %s: %v", ver1, err)
+	}
+	if !(v1.Major == 3 && v1.Minor == 6 && v1.Patch == 1) {
+		t.Errorf("Parse failed on %s, returned %+v", ver1, v1)
 	}
 
 	var ver2 = "1.0.0-alpha.3"
-	v2 := ConStructor(ver2)
-	result = (v2.pre == "alpha.3")
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver2, v2)
+	v2, err := Parse(ver2)
+	if err != nil {
+		t.Fatalf("Parse failed on %s: %v", ver2, err)
+	}
+	if !reflect.DeepEqual(v2.Pre, []string{"alpha", "3"}) {
+		t.Errorf("Parse failed on %s, returned %+v", ver2, v2)
 	}
 
 	var ver3 = "8.7.9+exp.sha.111334"
-	v3 := ConStructor(ver3)
-	result = (v3.build == "exp.sha.111334")
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver3, v3)
+	v3, err := Parse(ver3)
+	if err != nil {
+		t.Fatalf("Parse failed on %s: %v", ver3, err)
+	}
+	if !reflect.DeepEqual(v3.Build, []string{"exp", "sha", "111334"}) {
+		t.Errorf("Parse failed on %s, returned %+v", ver3, v3)
 	}
 
 	var ver4 = "1.3.0-rc.1+exp.sha.5114f85"
-	v4 := ConStructor(ver4)
-	result = (v4.major == "1" && v4.minor == "3" && v4.patch == "0" && v4.pre == "rc.1" && v4.build == "exp.sha.5114f85")
+	v4, err := Parse(ver4)
+	if err != nil {
+		t.Fatalf("Parse failed on %s: %v", ver4, err)
+	}
+	result := v4.Major == 1 && v4.Minor == 3 && v4.Patch == 0 &&
+		reflect.DeepEqual(v4.Pre, []string{"rc", "1"}) &&
+		reflect.DeepEqual(v4.Build, []string{"exp", "sha", "5114f85"})
 	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver4, v4)
+		t.Errorf("Parse failed on %s, returned %+v", ver4, v4)
 	}
-
 }
 
 func TestConvertToString(t *testing.T) {
 	var ver1 = "3.6.1"
-	v1 := ConStructor(ver1)
-	var result = (ver1 == v1.ConvertToString())
-	if !result {
-		t.Errorf("String Conversion failed on %s, returned %+v", ver1, v1.ConvertToString())
+	v1 := MustParse(ver1)
+	if ver1 != v1.ConvertToString() {
+		t.Errorf("String conversion failed on %s, returned %s", ver1, v1.ConvertToString())
 	}
 
 	var ver2 = "1.0.0-alpha.3"
-	v2 := ConStructor(ver2)
-	result = (ver2 == v2.ConvertToString())
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver2, v2.ConvertToString())
+	v2 := MustParse(ver2)
+	if ver2 != v2.ConvertToString() {
+		t.Errorf("String conversion failed on %s, returned %s", ver2, v2.ConvertToString())
 	}
 
 	var ver3 = "8.7.9+exp.sha.111334"
-	v3 := ConStructor(ver3)
-	result = (ver3 == v3.ConvertToString())
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver3, v3.ConvertToString())
+	v3 := MustParse(ver3)
+	if ver3 != v3.ConvertToString() {
+		t.Errorf("String conversion failed on %s, returned %s", ver3, v3.ConvertToString())
 	}
 
 	var ver4 = "1.3.0-rc.1+exp.sha.5114f85"
-	v4 := ConStructor(ver4)
-	result = (ver4 == v4.ConvertToString())
-	if !result {
-		t.Errorf("Constructor failed on %s, returned %+v", ver4, v4.ConvertToString())
+	v4 := MustParse(ver4)
+	if ver4 != v4.ConvertToString() {
+		t.Errorf("String conversion failed on %s, returned %s", ver4, v4.ConvertToString())
 	}
 }
 
 func TestComparisons(t *testing.T) {
 	ver1, ver2, ver3, ver4, ver5 := "1.3.0", "1.3.2", "1.4.0", "2.0.1", "2.0.1+build.125124"
-	v1 := ConStructor(ver1)
-	v2 := ConStructor(ver2)
-	v3 := ConStructor(ver3)
-	v4 := ConStructor(ver4)
-	v5 := ConStructor(ver5)
-	if v1.NewerThan(*v2) {
+	v1 := MustParse(ver1)
+	v2 := MustParse(ver2)
+	v3 := MustParse(ver3)
+	v4 := MustParse(ver4)
+	v5 := MustParse(ver5)
+	if v1.NewerThan(v2) {
 		t.Errorf("%s is not newer than %s", ver1, ver2)
 	}
-	if v5.OlderThan(*v1) {
+	if v5.OlderThan(v1) {
 		t.Errorf("%s is not older than %s", ver5, ver1)
 	}
-	if v3.OlderThan(*v2) {
+	if v3.OlderThan(v2) {
 		t.Errorf("%s is not older than %s", ver3, ver2)
 	}
-	if !v4.EquivalentTo(*v5) {
+	if !v4.EquivalentTo(v5) {
 		t.Errorf("%s is actually equivalent to %s", ver4, ver5)
 	}
 }
@@ -137,7 +146,7 @@ func TestIncrement(t *testing.T) {
 	 */
 	ver = Increment(ver, PATCH)
 	if ver != "1.3.10" {
-		t.Errorf("New version should be 1.3.10, was: ", ver)
+		t.Errorf("New version should be 1.3.10, was: %s", ver)
 	}
 	/*	7) 	Minor version Y (x.Y.z | x > 0) MUST be incremented if new, backwards compatible
 	 * 	functionality is introduced to the public API.
@@ -150,7 +159,7 @@ func TestIncrement(t *testing.T) {
 	ver = Increment(ver, PATCH)
 	ver = Increment(ver, MINOR)
 	if ver != "1.4.0" {
-		t.Errorf("New version should be 1.4.0, was: ", ver)
+		t.Errorf("New version should be 1.4.0, was: %s", ver)
 	}
 	/*	8)	Major version X (X.y.z | X > 0) MUST be incremented if any backwards incompatible changes
 	 *	 	are introduced to the public API.
@@ -162,7 +171,7 @@ func TestIncrement(t *testing.T) {
 	ver = Increment(ver, PATCH)
 	ver = Increment(ver, MAJOR)
 	if ver != "2.0.0" {
-		t.Errorf("New version should be 2.0.0, was: ", ver)
+		t.Errorf("New version should be 2.0.0, was: %s", ver)
 	}
 }
 
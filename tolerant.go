@@ -0,0 +1,47 @@
+package semver
+
+import "strings"
+
+/* Git tags are conventionally of the form "v1.2.3", and partial versions
+ * like "v1" or "v1.2" show up too; today IsValid("v1.2.3") returns false and
+ * forces callers to strip the prefix themselves. ParseTolerant and Canonical
+ * give them an explicit opt-in to that leniency, while Parse/IsValid stay
+ * strict.
+ */
+
+// ParseTolerant parses version like Parse, but also accepts a leading v/V,
+// surrounding whitespace, and missing minor/patch components, filling them
+// in with zero ("v1" -> "1.0.0", "v1.2" -> "1.2.0").
+func ParseTolerant(version string) (Semver, error) {
+	s := strings.TrimSpace(version)
+	s = strings.TrimPrefix(s, "v")
+	s = strings.TrimPrefix(s, "V")
+	if rxMatch.MatchString(s) {
+		return Parse(s)
+	}
+
+	core := s
+	var rest string
+	if idx := strings.IndexAny(s, "-+"); idx >= 0 {
+		core = s[:idx]
+		rest = s[idx:]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return Semver{}, diagnose(s)
+	}
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	return Parse(strings.Join(parts, ".") + rest)
+}
+
+// Canonical returns the strict, normalized form of version (as accepted by
+// ParseTolerant), or "" if version doesn't parse.
+func Canonical(version string) string {
+	v, err := ParseTolerant(version)
+	if err != nil {
+		return ""
+	}
+	return v.ConvertToString()
+}
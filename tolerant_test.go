@@ -0,0 +1,48 @@
+package semver
+
+import "testing"
+
+func TestParseTolerant(t *testing.T) {
+	cases := map[string]string{
+		"v1":            "1.0.0",
+		"V1.2":          "1.2.0",
+		"  v1.2.3  ":    "1.2.3",
+		"1.2.3":         "1.2.3",
+		"v1.2.3-beta+1": "1.2.3-beta+1",
+		"v1-beta":       "1.0.0-beta",
+	}
+	for in, want := range cases {
+		v, err := ParseTolerant(in)
+		if err != nil {
+			t.Errorf("ParseTolerant(%q) returned error: %v", in, err)
+			continue
+		}
+		if got := v.ConvertToString(); got != want {
+			t.Errorf("ParseTolerant(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestParseTolerantInvalid(t *testing.T) {
+	if _, err := ParseTolerant("v1.2.3.4"); err == nil {
+		t.Errorf("expected error for version with too many components")
+	}
+	if _, err := ParseTolerant("not-a-version-at-all!!"); err == nil {
+		t.Errorf("expected error for garbage input")
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	if got := Canonical("v1.2"); got != "1.2.0" {
+		t.Errorf("Canonical(%q) = %q, want %q", "v1.2", got, "1.2.0")
+	}
+	if got := Canonical("garbage"); got != "" {
+		t.Errorf("Canonical(%q) = %q, want empty string", "garbage", got)
+	}
+}
+
+func TestIsValidStaysStrict(t *testing.T) {
+	if IsValid("v1.2.3") {
+		t.Errorf("IsValid should remain strict and reject a leading v")
+	}
+}